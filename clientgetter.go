@@ -0,0 +1,124 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// ClientGetter abstracts the pieces of genericclioptions.ConfigFlags that the
+// peek subcommands depend on. Depending on this interface instead of on
+// *genericclioptions.ConfigFlags directly lets each typed subcommand share a
+// single Complete implementation and lets tests substitute a fake getter
+// instead of talking to a real cluster.
+type ClientGetter interface {
+	// ToRESTMapper returns a RESTMapper that can translate short resource
+	// names (e.g. "pods") into GroupVersionResources.
+	ToRESTMapper() (meta.RESTMapper, error)
+	// ToRESTConfig returns the REST config used to build clients.
+	ToRESTConfig() (*rest.Config, error)
+	// ToRawKubeConfigLoader returns the loader used to resolve the current
+	// namespace and context.
+	ToRawKubeConfigLoader() clientcmd.ClientConfig
+	// DynamicClient returns a dynamic client built from ToRESTConfig.
+	DynamicClient() (dynamic.Interface, error)
+	// CurrentContext returns the name of the kubeconfig context in effect,
+	// honoring a --context override.
+	CurrentContext() (string, error)
+	// ForContext returns a ClientGetter for the same kubeconfig but with
+	// contextName selected instead of whatever --context (or the
+	// kubeconfig's current-context) would otherwise select. Used by the
+	// multi-cluster fan-out mode to build one client per context.
+	ForContext(contextName string) ClientGetter
+}
+
+// configFlagsGetter is the default ClientGetter, backed by the standard
+// genericclioptions.ConfigFlags used by kubectl and its plugins.
+type configFlagsGetter struct {
+	configFlags *genericclioptions.ConfigFlags
+}
+
+// NewClientGetter returns a ClientGetter backed by configFlags.
+func NewClientGetter(configFlags *genericclioptions.ConfigFlags) ClientGetter {
+	return &configFlagsGetter{configFlags: configFlags}
+}
+
+func (g *configFlagsGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	return g.configFlags.ToRESTMapper()
+}
+
+func (g *configFlagsGetter) ToRESTConfig() (*rest.Config, error) {
+	return g.configFlags.ToRESTConfig()
+}
+
+func (g *configFlagsGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return g.configFlags.ToRawKubeConfigLoader()
+}
+
+func (g *configFlagsGetter) DynamicClient() (dynamic.Interface, error) {
+	restConfig, err := g.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(restConfig)
+}
+
+func (g *configFlagsGetter) ForContext(contextName string) ClientGetter {
+	// ConfigFlags carries an internal sync.Once/mutex for its cached
+	// clientConfig, so it can't be copied by value. Build a fresh one
+	// instead, reusing every overridable field from the original except
+	// Context.
+	clone := genericclioptions.NewConfigFlags(true)
+	clone.CacheDir = g.configFlags.CacheDir
+	clone.KubeConfig = g.configFlags.KubeConfig
+	clone.ClusterName = g.configFlags.ClusterName
+	clone.AuthInfoName = g.configFlags.AuthInfoName
+	clone.Namespace = g.configFlags.Namespace
+	clone.APIServer = g.configFlags.APIServer
+	clone.TLSServerName = g.configFlags.TLSServerName
+	clone.Insecure = g.configFlags.Insecure
+	clone.CertFile = g.configFlags.CertFile
+	clone.KeyFile = g.configFlags.KeyFile
+	clone.CAFile = g.configFlags.CAFile
+	clone.BearerToken = g.configFlags.BearerToken
+	clone.Impersonate = g.configFlags.Impersonate
+	clone.ImpersonateUID = g.configFlags.ImpersonateUID
+	clone.ImpersonateGroup = g.configFlags.ImpersonateGroup
+	clone.Username = g.configFlags.Username
+	clone.Password = g.configFlags.Password
+	clone.Timeout = g.configFlags.Timeout
+	clone.DisableCompression = g.configFlags.DisableCompression
+	clone.WrapConfigFn = g.configFlags.WrapConfigFn
+	clone.Context = &contextName
+
+	return &configFlagsGetter{configFlags: clone}
+}
+
+func (g *configFlagsGetter) CurrentContext() (string, error) {
+	if g.configFlags.Context != nil && *g.configFlags.Context != "" {
+		return *g.configFlags.Context, nil
+	}
+	raw, err := g.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return "", err
+	}
+	return raw.CurrentContext, nil
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// newCmdPeekDeployments creates the "peek deployments" subcommand.
+func newCmdPeekDeployments(streams genericclioptions.IOStreams, getter ClientGetter) *cobra.Command {
+	return newTypedPeekCommand(streams, getter,
+		"deployments",
+		"deployments.apps",
+		"Peek at the first N deployments",
+		`
+  # Peek at the first 10 deployments in the current namespace
+  kubectl peek deployments
+
+  # Peek at the first 5 deployments in wide format
+  kubectl peek deployments --limit 5 -o wide
+`,
+		nil,
+	)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// autoBookmarkPrefix marks the bookmark entries peek updates automatically
+// after every page it fetches, as opposed to ones the user named with
+// --bookmark. They're hidden from --list-bookmarks.
+const autoBookmarkPrefix = "auto/"
+
+// historyKey identifies one page-able query. Continue tokens are opaque and
+// only valid for the exact query that produced them, so bookmarks are
+// scoped to this tuple.
+type historyKey struct {
+	Context       string
+	Namespace     string
+	GVR           string
+	Selector      string
+	FieldSelector string
+	Limit         int64
+}
+
+// autoName is the reserved bookmark name peek updates after every page it
+// fetches for this key, so "--resume last" works without the user ever
+// having passed --bookmark.
+func (k historyKey) autoName() string {
+	return fmt.Sprintf("%s%s/%s/%s/%s/%s/%d", autoBookmarkPrefix, k.Context, k.Namespace, k.GVR, k.Selector, k.FieldSelector, k.Limit)
+}
+
+// bookmarkEntry is one saved continue token: the page it resumes from, and
+// the query it belongs to.
+type bookmarkEntry struct {
+	Key           historyKey `json:"key"`
+	ContinueToken string     `json:"continueToken"`
+	Page          int64      `json:"page"`
+}
+
+// historyFile is the on-disk shape of $XDG_STATE_HOME/kubectl-peek/history.json.
+type historyFile struct {
+	Bookmarks map[string]bookmarkEntry `json:"bookmarks"`
+}
+
+// historyFilePath returns the path to the history file, following the
+// XDG Base Directory spec with a conventional fallback when
+// $XDG_STATE_HOME isn't set.
+func historyFilePath() (string, error) {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "kubectl-peek", "history.json"), nil
+}
+
+func loadHistory() (*historyFile, error) {
+	h := &historyFile{Bookmarks: map[string]bookmarkEntry{}}
+
+	path, err := historyFilePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, err
+	}
+	if h.Bookmarks == nil {
+		h.Bookmarks = map[string]bookmarkEntry{}
+	}
+	return h, nil
+}
+
+func (h *historyFile) save() error {
+	path, err := historyFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// recordHistory is called after every page peek fetches. It always updates
+// the reserved "auto" bookmark for key, and additionally saves bookmarkName
+// (if the caller passed --bookmark) under its own name.
+func recordHistory(key historyKey, continueToken string, page int64, bookmarkName string) error {
+	h, err := loadHistory()
+	if err != nil {
+		return err
+	}
+
+	entry := bookmarkEntry{Key: key, ContinueToken: continueToken, Page: page}
+	h.Bookmarks[key.autoName()] = entry
+	if bookmarkName != "" {
+		h.Bookmarks[bookmarkName] = entry
+	}
+
+	return h.save()
+}
+
+// resolveBookmark resolves --resume NAME (or --resume last) to a starting
+// continue token and page number.
+func resolveBookmark(key historyKey, name string) (string, int64, error) {
+	h, err := loadHistory()
+	if err != nil {
+		return "", 0, err
+	}
+
+	lookup := name
+	if name == "last" {
+		lookup = key.autoName()
+	}
+
+	entry, ok := h.Bookmarks[lookup]
+	if !ok {
+		return "", 0, fmt.Errorf("no bookmark named %q was found", name)
+	}
+	return entry.ContinueToken, entry.Page, nil
+}
+
+// listBookmarks prints every user-named bookmark. The automatically
+// recorded "last" entries are an implementation detail and are omitted.
+func listBookmarks(out io.Writer) error {
+	h, err := loadHistory()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range h.Bookmarks {
+		if strings.HasPrefix(name, autoBookmarkPrefix) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		fmt.Fprintln(out, "No bookmarks saved.")
+		return nil
+	}
+	for _, name := range names {
+		e := h.Bookmarks[name]
+		fmt.Fprintf(out, "%s\tpage %d\t%s (namespace %q, context %q)\n", name, e.Page, e.Key.GVR, e.Key.Namespace, e.Key.Context)
+	}
+	return nil
+}
+
+// clearBookmarks deletes the entire history file, including the
+// automatically recorded "last" entries.
+func clearBookmarks() error {
+	path, err := historyFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordAndResolveBookmark(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	key := historyKey{Context: "ctx", Namespace: "default", GVR: "v1/pods", Limit: 10}
+
+	if err := recordHistory(key, "token-page-2", 2, "checkpoint"); err != nil {
+		t.Fatalf("recordHistory: %v", err)
+	}
+
+	token, page, err := resolveBookmark(key, "checkpoint")
+	if err != nil {
+		t.Fatalf("resolveBookmark(checkpoint): %v", err)
+	}
+	if token != "token-page-2" || page != 2 {
+		t.Fatalf("resolveBookmark(checkpoint) = (%q, %d), want (%q, %d)", token, page, "token-page-2", 2)
+	}
+
+	// "last" resolves to the same entry through the automatic entry that
+	// recordHistory saves alongside the named one.
+	token, page, err = resolveBookmark(key, "last")
+	if err != nil {
+		t.Fatalf("resolveBookmark(last): %v", err)
+	}
+	if token != "token-page-2" || page != 2 {
+		t.Fatalf("resolveBookmark(last) = (%q, %d), want (%q, %d)", token, page, "token-page-2", 2)
+	}
+}
+
+func TestResolveBookmarkUnknownName(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	key := historyKey{Context: "ctx", Namespace: "default", GVR: "v1/pods", Limit: 10}
+	if _, _, err := resolveBookmark(key, "does-not-exist"); err == nil {
+		t.Fatal("expected an error resolving a bookmark that was never saved")
+	}
+}
+
+func TestResolveBookmarkScopedToKey(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	podsKey := historyKey{Context: "ctx", Namespace: "default", GVR: "v1/pods", Limit: 10}
+	deploysKey := historyKey{Context: "ctx", Namespace: "default", GVR: "apps/v1/deployments", Limit: 10}
+
+	if err := recordHistory(podsKey, "pods-token", 2, ""); err != nil {
+		t.Fatalf("recordHistory: %v", err)
+	}
+
+	// "last" is scoped per-key, so a different query's "last" must not see
+	// pods' continue token.
+	if _, _, err := resolveBookmark(deploysKey, "last"); err == nil {
+		t.Fatal("expected resolveBookmark(last) to fail for a query that never ran")
+	}
+}
+
+func TestListBookmarksHidesAutoEntries(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	key := historyKey{Context: "ctx", Namespace: "default", GVR: "v1/pods", Limit: 10}
+	// No --bookmark name passed, so only the automatic "last" entry is saved.
+	if err := recordHistory(key, "tok", 1, ""); err != nil {
+		t.Fatalf("recordHistory: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := listBookmarks(&buf); err != nil {
+		t.Fatalf("listBookmarks: %v", err)
+	}
+	if got := buf.String(); got != "No bookmarks saved.\n" {
+		t.Fatalf("expected the auto-only bookmark to stay hidden, got %q", got)
+	}
+
+	if err := recordHistory(key, "tok2", 2, "checkpoint"); err != nil {
+		t.Fatalf("recordHistory: %v", err)
+	}
+	buf.Reset()
+	if err := listBookmarks(&buf); err != nil {
+		t.Fatalf("listBookmarks: %v", err)
+	}
+	if got := buf.String(); got == "No bookmarks saved.\n" {
+		t.Fatal("expected the named bookmark to be listed")
+	}
+}
+
+func TestClearBookmarksRemovesFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", dir)
+
+	key := historyKey{Context: "ctx", Namespace: "default", GVR: "v1/pods", Limit: 10}
+	if err := recordHistory(key, "tok", 1, "checkpoint"); err != nil {
+		t.Fatalf("recordHistory: %v", err)
+	}
+
+	historyPath := filepath.Join(dir, "kubectl-peek", "history.json")
+	if _, err := os.Stat(historyPath); err != nil {
+		t.Fatalf("expected history file to exist: %v", err)
+	}
+
+	if err := clearBookmarks(); err != nil {
+		t.Fatalf("clearBookmarks: %v", err)
+	}
+	if _, err := os.Stat(historyPath); !os.IsNotExist(err) {
+		t.Fatalf("expected history file to be removed, got err=%v", err)
+	}
+
+	// Clearing an already-empty history must not be an error.
+	if err := clearBookmarks(); err != nil {
+		t.Fatalf("clearBookmarks on already-empty history: %v", err)
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/rest"
+)
+
+// contextClient is everything runMultiContext needs to peek at one
+// kubeconfig context.
+type contextClient struct {
+	name       string
+	restClient rest.Interface
+	gvr        schema.GroupVersionResource
+	namespace  string
+}
+
+// contextPage is the outcome of fetching one page from one context: either
+// a table or an error, never both.
+type contextPage struct {
+	table *metav1.Table
+	err   error
+}
+
+// runMultiContext peeks at the same resource across several kubeconfig
+// contexts concurrently (bounded by --max-concurrency) and merges the
+// results into a single table with a leading CONTEXT column. A failure in
+// one context doesn't abort the others; every error is collected and
+// reported in a summary once the run finishes.
+func (o *PeekOptions) runMultiContext() error {
+	names, err := o.resolveContexts()
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no contexts matched --contexts/--all-contexts")
+	}
+
+	clients := make(map[string]*contextClient, len(names))
+	errs := make(map[string]string)
+	for _, name := range names {
+		c, err := o.buildContextClient(name)
+		if err != nil {
+			errs[name] = err.Error()
+			continue
+		}
+		clients[name] = c
+	}
+
+	// There's no per-context flag to seed these from: a continue token is
+	// only valid for the exact cluster and list that issued it, so a single
+	// --continue value couldn't be replayed across every context even if
+	// Validate allowed it. Every context always starts from page 1; the
+	// tokens printed below are informational only for now.
+	continueTokens := make(map[string]string, len(clients))
+
+	for {
+		active := activeContextNames(names, clients, continueTokens, errs)
+		if len(active) == 0 {
+			break
+		}
+
+		pages := o.fetchPages(clients, active, continueTokens)
+
+		combined := &metav1.Table{}
+		anyRows := false
+		for _, name := range active {
+			page := pages[name]
+			if page.err != nil {
+				errs[name] = page.err.Error()
+				delete(continueTokens, name)
+				continue
+			}
+			if combined.ColumnDefinitions == nil {
+				combined.ColumnDefinitions = append(
+					[]metav1.TableColumnDefinition{{Name: "Context", Type: "string"}},
+					page.table.ColumnDefinitions...)
+			}
+			for _, row := range page.table.Rows {
+				cells := append([]interface{}{name}, row.Cells...)
+				combined.Rows = append(combined.Rows, metav1.TableRow{Cells: cells, Object: row.Object})
+			}
+			anyRows = anyRows || len(page.table.Rows) > 0
+			continueTokens[name] = page.table.Continue
+		}
+
+		if anyRows {
+			printer := printers.NewTablePrinter(o.printOptions())
+			if err := printer.PrintObj(combined, o.Out); err != nil {
+				return err
+			}
+		}
+
+		if !anyContinueTokens(continueTokens) {
+			break
+		}
+
+		if o.interactive {
+			fmt.Fprintf(o.Out, "\n--- [n] next page (all contexts), [q] quit: ")
+			reader := bufio.NewReader(os.Stdin)
+			char, _, err := reader.ReadRune()
+			if err != nil {
+				return err
+			}
+			fmt.Println()
+			if char != 'n' {
+				break
+			}
+		} else {
+			printContinueTokens(o.Out, names, continueTokens)
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		fmt.Fprintln(o.ErrOut, "\nErrors:")
+		names := make([]string, 0, len(errs))
+		for name := range errs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(o.ErrOut, "  %s: %s\n", name, errs[name])
+		}
+	}
+
+	return nil
+}
+
+// activeContextNames returns the subset of names that still have a page to
+// fetch: those that haven't errored yet, and - once the first round has
+// run - still have a non-empty continue token.
+func activeContextNames(names []string, clients map[string]*contextClient, continueTokens map[string]string, errs map[string]string) []string {
+	var active []string
+	for _, name := range names {
+		if _, built := clients[name]; !built {
+			continue // failed to build a client for this context; already recorded as an error
+		}
+		if _, errored := errs[name]; errored {
+			continue
+		}
+		if token, seen := continueTokens[name]; seen && token == "" {
+			continue // this context's list is exhausted
+		}
+		active = append(active, name)
+	}
+	return active
+}
+
+// printContinueTokens reports the continue token each context ended its
+// round on, so a user who quit a non-interactive multi-context run mid-list
+// can at least see where every context left off.
+func printContinueTokens(out io.Writer, names []string, continueTokens map[string]string) {
+	fmt.Fprintln(out)
+	for _, name := range names {
+		if token := continueTokens[name]; token != "" {
+			fmt.Fprintf(out, "%s  Continue Token: %s\n", name, token)
+		}
+	}
+}
+
+func anyContinueTokens(continueTokens map[string]string) bool {
+	for _, token := range continueTokens {
+		if token != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchPages fetches one page from each of the given contexts concurrently,
+// bounded by o.maxConcurrency.
+func (o *PeekOptions) fetchPages(clients map[string]*contextClient, active []string, continueTokens map[string]string) map[string]contextPage {
+	sem := make(chan struct{}, o.maxConcurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	pages := make(map[string]contextPage, len(active))
+
+	for _, name := range active {
+		c := clients[name]
+		token := continueTokens[name]
+
+		wg.Add(1)
+		go func(name string, c *contextClient, token string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			table, err := o.getTablePage(c.restClient, c.gvr, c.namespace, token)
+
+			mu.Lock()
+			pages[name] = contextPage{table: table, err: err}
+			mu.Unlock()
+		}(name, c, token)
+	}
+
+	wg.Wait()
+	return pages
+}
+
+// resolveContexts turns --contexts/--all-contexts into a sorted list of
+// context names, sorted for deterministic non-interactive output.
+func (o *PeekOptions) resolveContexts() ([]string, error) {
+	if o.allContexts {
+		raw, err := o.getter.ToRawKubeConfigLoader().RawConfig()
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(raw.Contexts))
+		for name := range raw.Contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names, nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(o.contexts, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// buildContextClient resolves everything needed to peek at contextName:
+// its own RESTMapper (the resource may map to a different GVR per
+// cluster), namespace, and a Table-negotiating REST client.
+func (o *PeekOptions) buildContextClient(contextName string) (*contextClient, error) {
+	getter := o.getter.ForContext(contextName)
+
+	mapper, err := getter.ToRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+	gvr, err := resourceGVRFor(mapper, o.resource)
+	if err != nil {
+		return nil, err
+	}
+
+	restConfig, err := getter.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	restClient, err := newRestClient(*restConfig, gvr.GroupVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, _, err := getter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return nil, err
+	}
+	if o.allNamespaces {
+		namespace = ""
+	}
+
+	return &contextClient{name: contextName, restClient: restClient, gvr: gvr, namespace: namespace}, nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+// fakeRESTMapper resolves every request to a fixed GVR; runMultiContext only
+// ever calls ResourceFor, so every other method panics if exercised.
+type fakeRESTMapper struct {
+	gvr schema.GroupVersionResource
+}
+
+func (m fakeRESTMapper) ResourceFor(schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	return m.gvr, nil
+}
+func (m fakeRESTMapper) KindFor(schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	panic("not implemented")
+}
+func (m fakeRESTMapper) KindsFor(schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	panic("not implemented")
+}
+func (m fakeRESTMapper) ResourcesFor(schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	panic("not implemented")
+}
+func (m fakeRESTMapper) RESTMapping(schema.GroupKind, ...string) (*meta.RESTMapping, error) {
+	panic("not implemented")
+}
+func (m fakeRESTMapper) RESTMappings(schema.GroupKind, ...string) ([]*meta.RESTMapping, error) {
+	panic("not implemented")
+}
+func (m fakeRESTMapper) ResourceSingularizer(string) (string, error) {
+	panic("not implemented")
+}
+
+// fakeClientConfig is a minimal clientcmd.ClientConfig that only answers
+// Namespace(), which is all buildContextClient needs from it.
+type fakeClientConfig struct {
+	namespace string
+}
+
+func (c fakeClientConfig) RawConfig() (api.Config, error)       { panic("not implemented") }
+func (c fakeClientConfig) ClientConfig() (*rest.Config, error)  { panic("not implemented") }
+func (c fakeClientConfig) Namespace() (string, bool, error)     { return c.namespace, false, nil }
+func (c fakeClientConfig) ConfigAccess() clientcmd.ConfigAccess { panic("not implemented") }
+
+// fakeClientGetter is a ClientGetter that never talks to a real cluster.
+// mapperErr, when set, makes buildContextClient fail for this context, which
+// is enough to exercise runMultiContext's per-context error isolation
+// without any network access.
+type fakeClientGetter struct {
+	mapperErr error
+	restHost  string
+	namespace string
+}
+
+func (g *fakeClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	if g.mapperErr != nil {
+		return nil, g.mapperErr
+	}
+	return fakeRESTMapper{gvr: schema.GroupVersionResource{Version: "v1", Resource: "pods"}}, nil
+}
+func (g *fakeClientGetter) ToRESTConfig() (*rest.Config, error) {
+	return &rest.Config{Host: g.restHost}, nil
+}
+func (g *fakeClientGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return fakeClientConfig{namespace: g.namespace}
+}
+func (g *fakeClientGetter) DynamicClient() (dynamic.Interface, error) { panic("not implemented") }
+func (g *fakeClientGetter) CurrentContext() (string, error)           { panic("not implemented") }
+func (g *fakeClientGetter) ForContext(contextName string) ClientGetter {
+	switch contextName {
+	case "unreachable":
+		return &fakeClientGetter{mapperErr: fmt.Errorf("boom")}
+	default:
+		// 127.0.0.1:1 refuses connections immediately, so fetching a page
+		// fails fast without ever leaving the machine.
+		return &fakeClientGetter{restHost: "http://127.0.0.1:1", namespace: "default"}
+	}
+}
+
+func TestRunMultiContextIsolatesPerContextErrors(t *testing.T) {
+	streams, _, outBuf, errBuf := genericclioptions.NewTestIOStreams()
+
+	o := NewPeekOptions(streams, &fakeClientGetter{})
+	o.resource = "pods"
+	o.contexts = "connectable,unreachable"
+
+	if err := o.runMultiContext(); err != nil {
+		t.Fatalf("runMultiContext returned an error, want nil (per-context failures must not abort the run): %v", err)
+	}
+
+	errOut := errBuf.String()
+	if !strings.Contains(errOut, "unreachable") || !strings.Contains(errOut, "boom") {
+		t.Fatalf("expected the client-build failure for %q to be reported, got %q", "unreachable", errOut)
+	}
+	if !strings.Contains(errOut, "connectable") {
+		t.Fatalf("expected the fetch failure for %q to be reported too, got %q", "connectable", errOut)
+	}
+
+	if outBuf.Len() != 0 {
+		t.Fatalf("expected no table output when every context failed, got %q", outBuf.String())
+	}
+}
+
+func TestRunMultiContextNoMatchingContexts(t *testing.T) {
+	streams, _, _, _ := genericclioptions.NewTestIOStreams()
+
+	o := NewPeekOptions(streams, &fakeClientGetter{})
+	o.resource = "pods"
+	o.contexts = " , "
+
+	if err := o.runMultiContext(); err == nil {
+		t.Fatal("expected an error when --contexts matches nothing")
+	}
+}
+
+func TestActiveContextNames(t *testing.T) {
+	clients := map[string]*contextClient{
+		"built":        {name: "built"},
+		"already-done": {name: "already-done"},
+	}
+	errs := map[string]string{"errored": "boom"}
+	continueTokens := map[string]string{"already-done": ""}
+
+	got := activeContextNames([]string{"built", "already-done", "errored", "never-built"}, clients, continueTokens, errs)
+
+	want := []string{"built"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("activeContextNames = %v, want %v", got, want)
+	}
+}
+
+func TestAnyContinueTokens(t *testing.T) {
+	if anyContinueTokens(map[string]string{"a": "", "b": ""}) {
+		t.Fatal("expected false when every token is empty")
+	}
+	if !anyContinueTokens(map[string]string{"a": "", "b": "tok"}) {
+		t.Fatal("expected true when at least one token is non-empty")
+	}
+}
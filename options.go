@@ -0,0 +1,461 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// DefaultPeekLimit is the default number of items to return per page.
+	DefaultPeekLimit int64 = 10
+	// DefaultMaxConcurrency is the default number of kubeconfig contexts
+	// peeked at in parallel when --contexts or --all-contexts is used.
+	DefaultMaxConcurrency = 4
+)
+
+// PeekOptions provides the options and dependencies for the peek command and
+// its typed subcommands (e.g. "peek pods", "peek deployments").
+type PeekOptions struct {
+	getter     ClientGetter
+	printFlags *genericclioptions.PrintFlags
+
+	// User-provided resource type (e.g., "pods", "deployments.apps"). Typed
+	// subcommands set this directly instead of parsing it from args.
+	resource string
+	// multiResource is set by "peek workloads", which runs the same
+	// PeekOptions across several resource types in turn. Bookmarks/resume/
+	// page/continue are keyed per query (see historyKey) and peek workloads
+	// reuses the same --bookmark name for every resource it visits, so
+	// honoring them here would silently overwrite one resource's saved
+	// continue token with another's; Validate rejects the combination
+	// instead.
+	multiResource bool
+
+	// Flags for the peek command.
+	limit          int64
+	continueToken  string
+	interactive    bool
+	selector       string
+	fieldSelector  string
+	allNamespaces  bool
+	sortBy         string
+	noHeaders      bool
+	showLabels     bool
+	showKind       bool
+	bookmarkName   string
+	resumeName     string
+	targetPage     int64
+	listBookmarks  bool
+	clearBookmarks bool
+	contexts       string
+	allContexts    bool
+	maxConcurrency int
+
+	// Calculated values.
+	namespace     string
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+	restConfig    *rest.Config
+
+	genericclioptions.IOStreams
+}
+
+// NewPeekOptions returns a new instance of PeekOptions with default values.
+func NewPeekOptions(streams genericclioptions.IOStreams, getter ClientGetter) *PeekOptions {
+	return &PeekOptions{
+		getter:         getter,
+		printFlags:     genericclioptions.NewPrintFlags("").WithTypeSetter(scheme.Scheme),
+		limit:          DefaultPeekLimit,
+		maxConcurrency: DefaultMaxConcurrency,
+		IOStreams:      streams,
+	}
+}
+
+// Complete sets all information required for processing the command.
+func (o *PeekOptions) Complete() error {
+	var err error
+
+	// Create a RESTMapper to map resource names (like "pods") to GVRs.
+	o.mapper, err = o.getter.ToRESTMapper()
+	if err != nil {
+		return err
+	}
+
+	// Get the namespace from the flags.
+	o.namespace, _, err = o.getter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	// Create a dynamic client that can work with any resource type.
+	o.restConfig, err = o.getter.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	o.dynamicClient, err = o.getter.DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validate ensures that all required arguments and flag values are provided and valid.
+func (o *PeekOptions) Validate() error {
+	if o.resource == "" {
+		return fmt.Errorf("you must specify the type of resource to peek")
+	}
+	if o.limit <= 0 {
+		return fmt.Errorf("--limit must be a positive number")
+	}
+	if o.interactive && o.continueToken != "" {
+		return fmt.Errorf("cannot use --interactive and --continue flags together")
+	}
+	// Interactive mode doesn't make sense if the output is not for a human.
+	if o.interactive && (*o.printFlags.OutputFormat != "" && *o.printFlags.OutputFormat != "wide") {
+		return fmt.Errorf("interactive mode is only supported for standard and wide table output")
+	}
+	if o.sortBy != "" && o.interactive {
+		// A true --sort-by needs the full list buffered before anything is
+		// printed, which defeats the point of paging interactively.
+		return fmt.Errorf("--sort-by is not supported in interactive mode")
+	}
+	format := *o.printFlags.OutputFormat
+	if o.sortBy != "" && format != "" && format != "wide" {
+		return fmt.Errorf("--sort-by is only supported with table output")
+	}
+	if o.resumeName != "" && o.continueToken != "" {
+		return fmt.Errorf("cannot use --resume and --continue flags together")
+	}
+	if o.targetPage < 0 {
+		return fmt.Errorf("--page must be a positive number")
+	}
+	// Bookmarks and --page are recorded against the table pagination loop
+	// only (see Run); --sort-by and the structured output formats never
+	// call recordHistory, so silently accepting these flags there would
+	// look like a save/resume that never actually happened.
+	usesBookmarks := o.bookmarkName != "" || o.resumeName != "" || o.targetPage != 0
+	if usesBookmarks && o.sortBy != "" {
+		return fmt.Errorf("--bookmark, --resume, and --page are not supported together with --sort-by")
+	}
+	if usesBookmarks && format != "" && format != "wide" {
+		return fmt.Errorf("--bookmark, --resume, and --page are only supported with table output")
+	}
+	if o.multiResource && (usesBookmarks || o.continueToken != "") {
+		return fmt.Errorf("--bookmark, --resume, --page, and --continue are not supported with \"peek workloads\", since the same query is repeated for every workload type")
+	}
+	if o.contexts != "" && o.allContexts {
+		return fmt.Errorf("cannot use --contexts and --all-contexts together")
+	}
+	if o.maxConcurrency <= 0 {
+		return fmt.Errorf("--max-concurrency must be a positive number")
+	}
+	if (o.contexts != "" || o.allContexts) && (o.sortBy != "" || o.bookmarkName != "" || o.resumeName != "" || o.targetPage != 0 || o.continueToken != "") {
+		return fmt.Errorf("--sort-by, --bookmark, --resume, --page, and --continue are not supported together with --contexts/--all-contexts, since a continue token is only valid for the cluster that issued it")
+	}
+	if (o.contexts != "" || o.allContexts) && format != "" && format != "wide" {
+		return fmt.Errorf("--contexts/--all-contexts only support table output")
+	}
+	return nil
+}
+
+// Run executes the peek command logic: it pages through the requested
+// resource, printing each page as it arrives.
+func (o *PeekOptions) Run() error {
+	if o.contexts != "" || o.allContexts {
+		return o.runMultiContext()
+	}
+
+	gvr, err := o.getResourceGVR()
+	if err != nil {
+		return err
+	}
+
+	ns := o.namespace
+	if o.allNamespaces {
+		ns = "" // An empty string tells the client to query all namespaces.
+	}
+
+	// Non-table output formats (json, yaml, jsonpath, go-template, name, ...)
+	// are served from the dynamic client instead of the Table-negotiating
+	// REST client below.
+	if format := *o.printFlags.OutputFormat; format != "" && format != "wide" {
+		return o.runStructured(gvr, ns, format)
+	}
+
+	// We need a REST client that can negotiate for Table output.
+	restClient, err := newRestClient(*o.restConfig, gvr.GroupVersion())
+	if err != nil {
+		return err
+	}
+
+	if o.sortBy != "" {
+		return o.runSorted(restClient, gvr, ns)
+	}
+
+	key, err := o.historyKey(gvr, ns)
+	if err != nil {
+		return err
+	}
+
+	continueToken := o.continueToken
+	page := int64(1)
+	if o.resumeName != "" {
+		continueToken, page, err = resolveBookmark(key, o.resumeName)
+		if err != nil {
+			return err
+		}
+	}
+	if o.targetPage > 0 {
+		continueToken, page, err = o.advanceToPage(restClient, gvr, ns, continueToken, page, o.targetPage)
+		if err != nil {
+			return err
+		}
+	}
+
+	isFirstRequest := true
+
+	for {
+		table, err := o.getTablePage(restClient, gvr, ns, continueToken)
+		if err != nil {
+			return err
+		}
+
+		// If it's the first page and there are no items, just say so and exit.
+		if isFirstRequest && len(table.Rows) == 0 {
+			fmt.Fprintln(o.Out, "No resources found.")
+			return nil
+		}
+
+		fmt.Fprintf(o.Out, "--- Page %d ---\n", page)
+		printer := printers.NewTablePrinter(o.printOptions())
+		if err := printer.PrintObj(table, o.Out); err != nil {
+			return err
+		}
+
+		isFirstRequest = false
+		continueToken = table.Continue
+
+		// If there's no token, we've reached the end of the list.
+		if continueToken == "" {
+			if o.interactive {
+				fmt.Fprintln(o.Out, "\n--- End of list ---")
+			}
+			return nil
+		}
+
+		if err := recordHistory(key, continueToken, page+1, o.bookmarkName); err != nil {
+			return err
+		}
+
+		// Handle pagination flow.
+		if o.interactive {
+			fmt.Fprintf(o.Out, "\n--- [n] next page, [q] quit: ")
+			reader := bufio.NewReader(os.Stdin)
+			char, _, err := reader.ReadRune()
+			if err != nil {
+				return err
+			}
+			fmt.Println() // Newline for clean formatting after user input.
+			if char != 'n' {
+				return nil // Quit on any key other than 'n'.
+			}
+			page++
+		} else {
+			// In non-interactive mode, print the token (and its page) and exit.
+			fmt.Fprintf(o.Out, "\nPage %d  Continue Token: %s\n", page+1, continueToken)
+			return nil
+		}
+	}
+}
+
+// historyKey builds the bookmark lookup key for the current query: continue
+// tokens are only meaningful for the exact context, namespace, resource,
+// selector, and page size that produced them.
+func (o *PeekOptions) historyKey(gvr schema.GroupVersionResource, ns string) (historyKey, error) {
+	context, err := o.getter.CurrentContext()
+	if err != nil {
+		return historyKey{}, err
+	}
+	return historyKey{
+		Context:       context,
+		Namespace:     ns,
+		GVR:           gvr.String(),
+		Selector:      o.selector,
+		FieldSelector: o.fieldSelector,
+		Limit:         o.limit,
+	}, nil
+}
+
+// advanceToPage walks forward from (token, currentPage) to targetPage by
+// fetching and discarding pages, for when the caller knows the page number
+// they want but not its exact continue token.
+func (o *PeekOptions) advanceToPage(restClient rest.Interface, gvr schema.GroupVersionResource, ns, token string, currentPage, targetPage int64) (string, int64, error) {
+	if targetPage < currentPage {
+		return "", 0, fmt.Errorf("--page %d is before the anchor page %d", targetPage, currentPage)
+	}
+	for currentPage < targetPage {
+		table, err := o.getTablePage(restClient, gvr, ns, token)
+		if err != nil {
+			return "", 0, err
+		}
+		token = table.Continue
+		if token == "" {
+			return "", 0, fmt.Errorf("the list ended before reaching page %d", targetPage)
+		}
+		currentPage++
+	}
+	return token, currentPage, nil
+}
+
+// getTablePage fetches a single page of the resource as a metav1.Table.
+func (o *PeekOptions) getTablePage(restClient rest.Interface, gvr schema.GroupVersionResource, namespace, continueToken string) (*metav1.Table, error) {
+	listOptions := metav1.ListOptions{
+		Limit:         o.limit,
+		Continue:      continueToken,
+		LabelSelector: o.selector,
+		FieldSelector: o.fieldSelector,
+	}
+
+	req := restClient.Get().
+		Namespace(namespace).
+		Resource(gvr.Resource).
+		VersionedParams(&listOptions, scheme.ParameterCodec)
+
+	// --sort-by and --show-labels both need the full object for each row,
+	// not just the server-rendered cells.
+	if o.sortBy != "" || o.showLabels {
+		req = req.Param("includeObject", string(metav1.IncludeObject))
+	}
+
+	table := &metav1.Table{}
+	if err := req.Do(context.Background()).Into(table); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// printOptions builds the printers.PrintOptions used for table output from
+// the formatting flags on o.
+func (o *PeekOptions) printOptions() printers.PrintOptions {
+	return printers.PrintOptions{
+		NoHeaders:  o.noHeaders,
+		WithKind:   o.showKind,
+		ShowLabels: o.showLabels,
+		Wide:       *o.printFlags.OutputFormat == "wide",
+	}
+}
+
+// runSorted handles the --sort-by path: since a global sort requires seeing
+// every row before anything can be printed, it pages through the entire
+// list internally, sorts the accumulated rows, and prints only the first
+// o.limit of them. --limit therefore acts as a display cap rather than a
+// page size when --sort-by is set.
+func (o *PeekOptions) runSorted(restClient rest.Interface, gvr schema.GroupVersionResource, namespace string) error {
+	var all metav1.Table
+	continueToken := o.continueToken
+
+	for {
+		page, err := o.getTablePage(restClient, gvr, namespace, continueToken)
+		if err != nil {
+			return err
+		}
+		if all.ColumnDefinitions == nil {
+			all.ColumnDefinitions = page.ColumnDefinitions
+		}
+		all.Rows = append(all.Rows, page.Rows...)
+
+		continueToken = page.Continue
+		if continueToken == "" {
+			break
+		}
+	}
+
+	if len(all.Rows) == 0 {
+		fmt.Fprintln(o.Out, "No resources found.")
+		return nil
+	}
+
+	if err := sortTableRows(all.Rows, o.sortBy); err != nil {
+		return err
+	}
+
+	if int64(len(all.Rows)) > o.limit {
+		all.Rows = all.Rows[:o.limit]
+	}
+
+	printer := printers.NewTablePrinter(o.printOptions())
+	return printer.PrintObj(&all, o.Out)
+}
+
+// newRestClient creates a REST client configured to request Table-formatted server-side printing.
+func newRestClient(config rest.Config, gv schema.GroupVersion) (rest.Interface, error) {
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	if gv.Group == "" {
+		config.APIPath = "/api"
+	}
+	config.AcceptContentTypes = "application/json;as=Table;v=v1;g=meta.k8s.io,application/json"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	return rest.RESTClientFor(&config)
+}
+
+// getResourceGVR finds the GroupVersionResource for a given short resource name.
+func (o *PeekOptions) getResourceGVR() (schema.GroupVersionResource, error) {
+	return resourceGVRFor(o.mapper, o.resource)
+}
+
+// resourceGVRFor finds the GroupVersionResource for a given short resource
+// name (e.g. "pods", "deployments.apps") using mapper. It's a standalone
+// function rather than a PeekOptions method so the multi-cluster fan-out
+// path can resolve it once per cluster's own RESTMapper.
+func resourceGVRFor(mapper meta.RESTMapper, resource string) (schema.GroupVersionResource, error) {
+	resourceArg := strings.ToLower(resource)
+
+	// Create a partial GVR from the user's argument. We don't know the version,
+	// so we leave it empty. The RESTMapper will find the best match.
+	// This approach handles "pods", "deployments", and "deployments.apps" style arguments.
+	gvrToFind := schema.GroupVersionResource{}
+	parts := strings.Split(resourceArg, ".")
+	if len(parts) == 2 {
+		gvrToFind = schema.GroupVersionResource{Group: parts[1], Resource: parts[0]}
+	} else {
+		gvrToFind = schema.GroupVersionResource{Resource: resourceArg}
+	}
+
+	gvr, err := mapper.ResourceFor(gvrToFind)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("the server doesn't have a resource type %q", resource)
+	}
+
+	return gvr, nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// newCmdPeekPods creates the "peek pods" subcommand.
+func newCmdPeekPods(streams genericclioptions.IOStreams, getter ClientGetter) *cobra.Command {
+	return newTypedPeekCommand(streams, getter,
+		"pods",
+		"pods",
+		"Peek at the first N running pods",
+		`
+  # Peek at the first 10 running pods in the current namespace
+  kubectl peek pods
+
+  # Peek at the first 20 running pods across all namespaces
+  kubectl peek pods -A --limit 20
+
+  # Peek at pods in any phase, overriding the running-only default
+  kubectl peek pods --field-selector ""
+`,
+		func(cmd *cobra.Command, o *PeekOptions) {
+			// Most of the time what you want from "peek pods" is the pods
+			// that are actually running, not ones that already completed or
+			// failed. --field-selector still overrides this if passed
+			// explicitly.
+			o.fieldSelector = "status.phase=Running"
+			cmd.Flags().Lookup("field-selector").DefValue = "status.phase=Running"
+		},
+	)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// NewCmdPeek creates the root "peek" command. It composes the typed,
+// resource-specific subcommands (e.g. "peek pods") along with a generic
+// "peek [type]" fallback that behaves like the plugin did before typed
+// subcommands existed, for resource types that don't have one yet.
+func NewCmdPeek(streams genericclioptions.IOStreams) *cobra.Command {
+	configFlags := genericclioptions.NewConfigFlags(true)
+	getter := NewClientGetter(configFlags)
+	o := NewPeekOptions(streams, getter)
+
+	cmd := &cobra.Command{
+		Use:   "peek [type]",
+		Short: "Efficiently peek at the first N resources from the API server",
+		Long: `The "peek" command allows you to retrieve just the first N items of a resource list,
+avoiding the high memory and network usage of "kubectl get" on clusters with many resources.
+It supports pagination through an interactive mode or by manually passing a continue token.
+
+Use one of the typed subcommands (e.g. "peek pods") for resource-specific defaults, or
+"peek <type>" directly for any resource known to the cluster.`,
+		Example: `
+  # Peek at the first 10 running pods in the current namespace
+  kubectl peek pods
+
+  # Peek at the first 5 deployments in wide format
+  kubectl peek deployments --limit 5 -o wide
+
+  # Interactively page through all services, 20 at a time
+  kubectl peek services --limit 20 -i
+
+  # Get the second page of pods, using a token from a previous run
+  kubectl peek pods --limit 10 --continue "eyJhbGciOi..."
+`,
+		SilenceUsage: true,
+		// Without an explicit Args validator cobra treats any first argument
+		// that isn't a subcommand name as an unknown command.
+		Args: cobra.ArbitraryArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			// --list-bookmarks and --clear-bookmarks only touch local state
+			// and don't need a resource type.
+			if !o.listBookmarks && !o.clearBookmarks {
+				if len(args) == 0 {
+					return fmt.Errorf("you must specify the type of resource to peek")
+				}
+				if len(args) > 1 {
+					return fmt.Errorf("only one resource type is allowed")
+				}
+				o.resource = args[0]
+			}
+			return runPeek(o)
+		},
+	}
+
+	addCommonFlags(cmd, o)
+	// Standard kubectl flags (--namespace, --context, --kubeconfig, ...) are
+	// registered as persistent flags so every typed subcommand inherits them.
+	configFlags.AddFlags(cmd.PersistentFlags())
+	cmd.AddCommand(
+		newCmdPeekPods(streams, getter),
+		newCmdPeekDeployments(streams, getter),
+		newCmdPeekWorkloads(streams, getter),
+	)
+
+	return cmd
+}
+
+// addCommonFlags registers the flags shared by the root "peek [type]" command
+// and every typed subcommand, binding them to o.
+func addCommonFlags(cmd *cobra.Command, o *PeekOptions) {
+	cmd.Flags().Int64Var(&o.limit, "limit", DefaultPeekLimit, "Number of items to return per page.")
+	cmd.Flags().StringVar(&o.continueToken, "continue", "", "A token used to retrieve the next page of results. If not provided, the first page is returned.")
+	cmd.Flags().BoolVarP(&o.interactive, "interactive", "i", false, "Enable interactive mode to page through results.")
+	cmd.Flags().StringVarP(&o.selector, "selector", "l", "", "Selector (label query) to filter on. Supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmd.Flags().StringVar(&o.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
+	cmd.Flags().BoolVarP(&o.allNamespaces, "all-namespaces", "A", false, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().StringVar(&o.sortBy, "sort-by", "", "If non-empty, sort list types using this JSONPath expression (e.g. '{.metadata.name}'). Requires buffering the full list, so it is refused in interactive mode and --limit becomes a display cap rather than a page size.")
+	cmd.Flags().BoolVar(&o.noHeaders, "no-headers", false, "When using the default output format, don't print headers.")
+	cmd.Flags().BoolVar(&o.showLabels, "show-labels", false, "When printing, show all labels as the last column.")
+	cmd.Flags().BoolVar(&o.showKind, "show-kind", false, "If present, list the resource type for the requested object(s).")
+	cmd.Flags().StringVar(&o.bookmarkName, "bookmark", "", "Save the continue token this run ends on under NAME, so a later --resume NAME can pick up where you left off.")
+	cmd.Flags().StringVar(&o.resumeName, "resume", "", `Resume from a saved continue token: a name previously passed to --bookmark, or "last" to resume the most recent run of this exact query.`)
+	cmd.Flags().Int64Var(&o.targetPage, "page", 0, "Walk forward to page N from the --resume anchor (or the start of the list) before printing, for when you don't have the exact continue token.")
+	cmd.Flags().BoolVar(&o.listBookmarks, "list-bookmarks", false, "List saved bookmarks and exit.")
+	cmd.Flags().BoolVar(&o.clearBookmarks, "clear-bookmarks", false, "Delete all saved bookmarks and exit.")
+	cmd.Flags().StringVar(&o.contexts, "contexts", "", "Comma-separated list of kubeconfig contexts to peek at concurrently, merging the results into one table with a leading CONTEXT column.")
+	cmd.Flags().BoolVar(&o.allContexts, "all-contexts", false, "Peek at every context in the kubeconfig, like --contexts but without having to name them.")
+	cmd.Flags().IntVar(&o.maxConcurrency, "max-concurrency", DefaultMaxConcurrency, "Maximum number of --contexts/--all-contexts contexts to peek at at once.")
+
+	o.printFlags.AddFlags(cmd)
+}
+
+// runPeek runs the full Complete/Validate/Run lifecycle for o. It is the
+// single entry point shared by the generic "peek [type]" command and every
+// typed subcommand, so pagination and printing behavior stays identical
+// across all of them.
+func runPeek(o *PeekOptions) error {
+	// Bookmark bookkeeping is local state and doesn't need a cluster
+	// connection at all.
+	if o.listBookmarks {
+		return listBookmarks(o.Out)
+	}
+	if o.clearBookmarks {
+		return clearBookmarks()
+	}
+
+	if err := o.Complete(); err != nil {
+		return err
+	}
+	if err := o.Validate(); err != nil {
+		return err
+	}
+	return o.Run()
+}
+
+// newTypedPeekCommand builds a subcommand that peeks at a fixed resource
+// type. configureFlags, if non-nil, is called after the common flags are
+// registered so a typed subcommand can add or override resource-specific
+// flags (e.g. pod's --field-selector default).
+func newTypedPeekCommand(streams genericclioptions.IOStreams, getter ClientGetter, use, resource, short, example string, configureFlags func(*cobra.Command, *PeekOptions)) *cobra.Command {
+	o := NewPeekOptions(streams, getter)
+	o.resource = resource
+
+	cmd := &cobra.Command{
+		Use:          use,
+		Short:        short,
+		Example:      example,
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			return runPeek(o)
+		},
+	}
+
+	addCommonFlags(cmd, o)
+	if configureFlags != nil {
+		configureFlags(cmd, o)
+	}
+
+	return cmd
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// sortTableRows sorts rows in place by the string value that the JSONPath
+// expression produces against each row's full object, mirroring
+// "kubectl get --sort-by". Rows are expected to carry their full object
+// (see the "includeObject" request param in getTablePage); a row whose
+// expression produces no match sorts before rows that do.
+func sortTableRows(rows []metav1.TableRow, expr string) error {
+	jp := jsonpath.New("sort-by").AllowMissingKeys(true)
+	if err := jp.Parse(relaxedJSONPathExpression(expr)); err != nil {
+		return fmt.Errorf("invalid --sort-by expression %q: %w", expr, err)
+	}
+
+	values := make([]string, len(rows))
+	for i, row := range rows {
+		var obj interface{}
+		if err := json.Unmarshal(row.Object.Raw, &obj); err != nil {
+			return fmt.Errorf("--sort-by requires the server to return full objects: %w", err)
+		}
+
+		results, err := jp.FindResults(obj)
+		if err != nil || len(results) == 0 || len(results[0]) == 0 {
+			continue
+		}
+		values[i] = fmt.Sprintf("%v", results[0][0].Interface())
+	}
+
+	sort.Stable(&rowSorter{rows: rows, values: values})
+	return nil
+}
+
+// rowSorter sorts a slice of table rows and their precomputed sort values
+// together.
+type rowSorter struct {
+	rows   []metav1.TableRow
+	values []string
+}
+
+func (s *rowSorter) Len() int { return len(s.rows) }
+func (s *rowSorter) Less(i, j int) bool {
+	return s.values[i] < s.values[j]
+}
+func (s *rowSorter) Swap(i, j int) {
+	s.rows[i], s.rows[j] = s.rows[j], s.rows[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
+
+// relaxedJSONPathExpression wraps a bare JSONPath expression (e.g.
+// ".metadata.name") in "{...}" if the caller didn't already, matching the
+// leniency kubectl affords to --sort-by.
+func relaxedJSONPathExpression(pathExpression string) string {
+	if len(pathExpression) == 0 {
+		return pathExpression
+	}
+	if pathExpression[0] != '{' {
+		return fmt.Sprintf("{%s}", pathExpression)
+	}
+	return pathExpression
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func namedRow(t *testing.T, name string) metav1.TableRow {
+	t.Helper()
+	raw, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"name": name},
+	})
+	if err != nil {
+		t.Fatalf("marshal fixture row: %v", err)
+	}
+	return metav1.TableRow{Object: runtime.RawExtension{Raw: raw}}
+}
+
+func rowName(t *testing.T, row metav1.TableRow) string {
+	t.Helper()
+	var obj struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(row.Object.Raw, &obj); err != nil {
+		t.Fatalf("unmarshal fixture row: %v", err)
+	}
+	return obj.Metadata.Name
+}
+
+func TestSortTableRowsByName(t *testing.T) {
+	rows := []metav1.TableRow{
+		namedRow(t, "charlie"),
+		namedRow(t, "alice"),
+		namedRow(t, "bob"),
+	}
+
+	if err := sortTableRows(rows, "{.metadata.name}"); err != nil {
+		t.Fatalf("sortTableRows: %v", err)
+	}
+
+	var got []string
+	for _, row := range rows {
+		got = append(got, rowName(t, row))
+	}
+	want := []string{"alice", "bob", "charlie"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("rows out of order: got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSortTableRowsAcceptsBareExpression(t *testing.T) {
+	rows := []metav1.TableRow{namedRow(t, "b"), namedRow(t, "a")}
+
+	// No surrounding "{...}", unlike the test above - relaxedJSONPathExpression
+	// should add it.
+	if err := sortTableRows(rows, ".metadata.name"); err != nil {
+		t.Fatalf("sortTableRows: %v", err)
+	}
+	if rowName(t, rows[0]) != "a" || rowName(t, rows[1]) != "b" {
+		t.Fatalf("rows out of order: %v, %v", rowName(t, rows[0]), rowName(t, rows[1]))
+	}
+}
+
+func TestSortTableRowsMissingKeyKeepsOrder(t *testing.T) {
+	rows := []metav1.TableRow{
+		namedRow(t, "bob"),
+		namedRow(t, ""), // neither row has a .metadata.nickname
+	}
+
+	if err := sortTableRows(rows, "{.metadata.nickname}"); err != nil {
+		t.Fatalf("sortTableRows: %v", err)
+	}
+	// Both rows produce no match for .metadata.nickname, so the sort must be
+	// stable and leave their relative order untouched.
+	if rowName(t, rows[0]) != "bob" || rowName(t, rows[1]) != "" {
+		t.Fatalf("expected a stable sort, got %v, %v", rowName(t, rows[0]), rowName(t, rows[1]))
+	}
+}
+
+func TestSortTableRowsInvalidExpression(t *testing.T) {
+	rows := []metav1.TableRow{namedRow(t, "bob")}
+
+	if err := sortTableRows(rows, "{.metadata.[}"); err == nil {
+		t.Fatal("expected an error for an invalid --sort-by expression")
+	}
+}
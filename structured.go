@@ -0,0 +1,255 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/dynamic"
+)
+
+// pageRenderer renders the unstructured items of one or more list pages to
+// o.Out. Begin/End bracket the whole run so a renderer can emit output that
+// spans pages (e.g. a single JSON array) without buffering every item.
+type pageRenderer interface {
+	Begin() error
+	RenderPage(items []unstructured.Unstructured) error
+	End() error
+}
+
+// runStructured serves -o json|yaml|jsonpath|go-template|name (anything
+// other than the default table/wide) from the dynamic client. It pages
+// through the list exactly like the table path, but renders each page
+// through a pageRenderer instead of a TablePrinter so multi-page output
+// stays a single logical document.
+func (o *PeekOptions) runStructured(gvr schema.GroupVersionResource, ns, format string) error {
+	var resource dynamic.ResourceInterface = o.dynamicClient.Resource(gvr)
+	if ns != "" {
+		resource = o.dynamicClient.Resource(gvr).Namespace(ns)
+	}
+
+	renderer, err := o.newPageRenderer(format)
+	if err != nil {
+		return err
+	}
+
+	continueToken := o.continueToken
+	isFirstRequest := true
+
+	if err := renderer.Begin(); err != nil {
+		return err
+	}
+
+	for {
+		list, err := resource.List(context.Background(), metav1.ListOptions{
+			Limit:         o.limit,
+			Continue:      continueToken,
+			LabelSelector: o.selector,
+			FieldSelector: o.fieldSelector,
+		})
+		if err != nil {
+			return err
+		}
+
+		if isFirstRequest && len(list.Items) == 0 {
+			fmt.Fprintln(o.Out, "No resources found.")
+			return nil
+		}
+
+		if err := renderer.RenderPage(list.Items); err != nil {
+			return err
+		}
+
+		isFirstRequest = false
+		continueToken = list.GetContinue()
+
+		if continueToken == "" {
+			return renderer.End()
+		}
+
+		// Validate rejects --interactive with any non-table/non-wide output
+		// format, and this path only runs for those formats, so o.interactive
+		// is always false here: paging a structured document only makes
+		// sense non-interactively, one continue token at a time.
+		if err := renderer.End(); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "\nContinue Token: %s\n", continueToken)
+		return nil
+	}
+}
+
+// newPageRenderer picks the pageRenderer for the requested output format.
+func (o *PeekOptions) newPageRenderer(format string) (pageRenderer, error) {
+	switch format {
+	case "json":
+		return &jsonListRenderer{out: o.Out}, nil
+	case "yaml":
+		return &yamlListRenderer{out: o.Out}, nil
+	default:
+		printer, err := o.printFlags.ToPrinter()
+		if err != nil {
+			return nil, err
+		}
+		return &itemRenderer{out: o.Out, printer: printer}, nil
+	}
+}
+
+// itemRenderer prints each item through a printers.ResourcePrinter, one item
+// at a time, which is how jsonpath, go-template, and name printers expect
+// to be driven.
+type itemRenderer struct {
+	out     io.Writer
+	printer printers.ResourcePrinter
+}
+
+func (r *itemRenderer) Begin() error { return nil }
+
+func (r *itemRenderer) RenderPage(items []unstructured.Unstructured) error {
+	for i := range items {
+		if err := r.printer.PrintObj(&items[i], r.out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *itemRenderer) End() error { return nil }
+
+// jsonListRenderer writes a synthetic "List" wrapper as a single JSON
+// document, streamed across pages: it appends one item at a time as pages
+// arrive and closes the document in End. This keeps memory use bounded by a
+// single page even when the underlying list spans many continue tokens.
+//
+// The opening preamble is deferred until the first item is actually about
+// to be written (rather than written eagerly in Begin), so a list that
+// turns out empty never leaves an unterminated JSON document behind if the
+// caller never calls RenderPage/End.
+type jsonListRenderer struct {
+	out           io.Writer
+	wrotePreamble bool
+	wroteItem     bool
+}
+
+func (r *jsonListRenderer) Begin() error { return nil }
+
+func (r *jsonListRenderer) writePreamble() error {
+	if r.wrotePreamble {
+		return nil
+	}
+	_, err := fmt.Fprint(r.out, `{"apiVersion":"v1","kind":"List","metadata":{},"items":[`)
+	r.wrotePreamble = true
+	return err
+}
+
+func (r *jsonListRenderer) RenderPage(items []unstructured.Unstructured) error {
+	for i := range items {
+		raw, err := json.Marshal(items[i].Object)
+		if err != nil {
+			return err
+		}
+		if err := r.writePreamble(); err != nil {
+			return err
+		}
+		if r.wroteItem {
+			if _, err := fmt.Fprint(r.out, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := r.out.Write(raw); err != nil {
+			return err
+		}
+		r.wroteItem = true
+	}
+	return nil
+}
+
+func (r *jsonListRenderer) End() error {
+	if err := r.writePreamble(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(r.out, "]}")
+	return err
+}
+
+// yamlListRenderer writes the same "List" wrapper as jsonListRenderer, but
+// as YAML, streamed across pages the same way: each item is marshaled and
+// written as its own "- "-prefixed sequence entry under "items:" as soon as
+// it arrives, rather than buffering the whole list before marshaling one
+// big document. This keeps memory use bounded by a single page, same as
+// the JSON renderer.
+type yamlListRenderer struct {
+	out       io.Writer
+	wroteItem bool
+}
+
+func (r *yamlListRenderer) Begin() error { return nil }
+
+func (r *yamlListRenderer) RenderPage(items []unstructured.Unstructured) error {
+	for i := range items {
+		raw, err := yaml.Marshal(items[i].Object)
+		if err != nil {
+			return err
+		}
+		if !r.wroteItem {
+			if _, err := fmt.Fprint(r.out, "apiVersion: v1\nitems:\n"); err != nil {
+				return err
+			}
+		}
+		if err := r.writeItem(raw); err != nil {
+			return err
+		}
+		r.wroteItem = true
+	}
+	return nil
+}
+
+// writeItem indents a single marshaled item as a YAML sequence entry under
+// "items:": "- " on the item's first line, two spaces on every line after.
+func (r *yamlListRenderer) writeItem(raw []byte) error {
+	lines := strings.Split(strings.TrimRight(string(raw), "\n"), "\n")
+	for i, line := range lines {
+		prefix := "  "
+		if i == 0 {
+			prefix = "- "
+		}
+		if _, err := fmt.Fprintln(r.out, prefix+line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *yamlListRenderer) End() error {
+	if !r.wroteItem {
+		if _, err := fmt.Fprint(r.out, "apiVersion: v1\nitems: []\n"); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(r.out, "kind: List\nmetadata: {}\n")
+	return err
+}
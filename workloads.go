@@ -0,0 +1,62 @@
+/*
+Copyright 2025 Sean Sullivan
+
+Licensed under the MIT License (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://opensource.org/licenses/MIT
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// workloadResources lists the resource types "peek workloads" peeks at, in
+// the order they are printed.
+var workloadResources = []string{"deployments.apps", "statefulsets.apps", "daemonsets.apps"}
+
+// newCmdPeekWorkloads creates the "peek workloads" subcommand, which peeks
+// at the common workload-controller resources one after another.
+func newCmdPeekWorkloads(streams genericclioptions.IOStreams, getter ClientGetter) *cobra.Command {
+	o := NewPeekOptions(streams, getter)
+	o.multiResource = true
+
+	cmd := &cobra.Command{
+		Use:   "workloads",
+		Short: "Peek at the first N deployments, statefulsets, and daemonsets",
+		Example: `
+  # Peek at the first 10 of each workload type in the current namespace
+  kubectl peek workloads
+
+  # Peek at the first 5 of each workload type across all namespaces
+  kubectl peek workloads -A --limit 5
+`,
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			for _, resource := range workloadResources {
+				fmt.Fprintf(o.Out, "--- %s ---\n", resource)
+				o.resource = resource
+				if err := runPeek(o); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	addCommonFlags(cmd, o)
+	return cmd
+}